@@ -0,0 +1,165 @@
+package glance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const lastfmAPIURL = "https://ws.audioscrobbler.com/2.0/"
+
+// lastfmProvider implements musicProvider against the Last.fm API. Unlike
+// Spotify it's a single flat API keyed by one api-key, with no OAuth dance -
+// per-user data is fetched by passing a public username rather than through
+// user authorization.
+type lastfmProvider struct {
+	apiKey   string
+	username string
+}
+
+func (p *lastfmProvider) Init(widgetID, contentType string) error {
+	if p.apiKey == "" {
+		return fmt.Errorf("api-key is required")
+	}
+
+	switch contentType {
+	case musicContentTopTracks, musicContentRecentlyPlayed, musicContentTopArtists:
+		if p.username == "" {
+			return fmt.Errorf("username is required for content-type %q", contentType)
+		}
+	}
+
+	return nil
+}
+
+func (p *lastfmProvider) call(ctx context.Context, method string, params url.Values, out any) error {
+	params.Set("method", method)
+	params.Set("api_key", p.apiKey)
+	params.Set("format", "json")
+
+	req, err := http.NewRequestWithContext(ctx, "GET", lastfmAPIURL+"?"+params.Encode(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create Last.fm request: %w", err)
+	}
+
+	resp, err := defaultHTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("Last.fm request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("Last.fm API returned status %d", resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode Last.fm response: %w", err)
+	}
+
+	return nil
+}
+
+type lastfmTrack struct {
+	Name   string `json:"name"`
+	Artist struct {
+		Text string `json:"#text"`
+	} `json:"artist"`
+	URL string `json:"url"`
+}
+
+func (t lastfmTrack) toMusicTrack() MusicTrack {
+	track := MusicTrack{
+		Name:    t.Name,
+		Artists: []MusicArtist{{Name: t.Artist.Text}},
+	}
+	track.ExternalUrls.Spotify = t.URL
+	return track
+}
+
+// FetchTopTracks and FetchRecentlyPlayed both read from
+// user.getrecenttracks - Last.fm's top-tracks endpoint only covers a fixed
+// window (7day/1month/...), whereas what a dashboard widget wants is just
+// "what has this person been listening to".
+func (p *lastfmProvider) fetchRecentTracks(ctx context.Context, opts musicFetchOptions) ([]any, error) {
+	params := url.Values{}
+	params.Set("user", p.username)
+	params.Set("limit", strconv.Itoa(opts.Limit))
+
+	var resp struct {
+		RecentTracks struct {
+			Track []lastfmTrack `json:"track"`
+		} `json:"recenttracks"`
+	}
+
+	if err := p.call(ctx, "user.getrecenttracks", params, &resp); err != nil {
+		return nil, err
+	}
+
+	items := make([]any, 0, len(resp.RecentTracks.Track))
+	for _, track := range resp.RecentTracks.Track {
+		items = append(items, track.toMusicTrack())
+	}
+
+	return items, nil
+}
+
+func (p *lastfmProvider) FetchTopTracks(ctx context.Context, opts musicFetchOptions) ([]any, error) {
+	items, err := p.fetchRecentTracks(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("fetching top tracks failed: %w", err)
+	}
+	return items, nil
+}
+
+func (p *lastfmProvider) FetchRecentlyPlayed(ctx context.Context, opts musicFetchOptions) ([]any, error) {
+	items, err := p.fetchRecentTracks(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("fetching recently played tracks failed: %w", err)
+	}
+	return items, nil
+}
+
+func (p *lastfmProvider) FetchTopArtists(ctx context.Context, opts musicFetchOptions) ([]any, error) {
+	params := url.Values{}
+	params.Set("user", p.username)
+	params.Set("limit", strconv.Itoa(opts.Limit))
+
+	var resp struct {
+		TopArtists struct {
+			Artist []struct {
+				Name string `json:"name"`
+				Mbid string `json:"mbid"`
+			} `json:"artist"`
+		} `json:"topartists"`
+	}
+
+	if err := p.call(ctx, "user.gettopartists", params, &resp); err != nil {
+		return nil, fmt.Errorf("fetching top artists failed: %w", err)
+	}
+
+	items := make([]any, 0, len(resp.TopArtists.Artist))
+	for _, artist := range resp.TopArtists.Artist {
+		items = append(items, MusicArtist{ID: artist.Mbid, Name: artist.Name})
+	}
+
+	return items, nil
+}
+
+// Last.fm doesn't have an equivalent of Spotify's catalog-browsing or
+// saved-library endpoints, so these content types simply aren't supported
+// by this provider.
+
+func (p *lastfmProvider) FetchNewReleases(ctx context.Context, opts musicFetchOptions) ([]any, error) {
+	return nil, fmt.Errorf("provider %q does not support content-type %q", "lastfm", musicContentNewReleases)
+}
+
+func (p *lastfmProvider) FetchSavedAlbums(ctx context.Context, opts musicFetchOptions) ([]any, error) {
+	return nil, fmt.Errorf("provider %q does not support content-type %q", "lastfm", musicContentSavedAlbums)
+}
+
+func (p *lastfmProvider) SearchAlbum(ctx context.Context, opts musicFetchOptions) ([]any, error) {
+	return nil, fmt.Errorf("provider %q does not support content-type %q", "lastfm", musicContentSearch)
+}
@@ -0,0 +1,247 @@
+package glance
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Overridable (rather than const) so tests can point them at an
+// httptest.Server instead of hitting the real Spotify endpoints.
+var (
+	spotifyAuthorizeURL = "https://accounts.spotify.com/authorize"
+	spotifyTokenURL     = "https://accounts.spotify.com/api/token"
+)
+
+// spotifyUserAuthSource implements spotifyTokenSource for content types that
+// need access to a specific user's library or listening history. It wraps
+// an on-disk refresh token (via store) with an in-memory access token cache
+// so a fresh one isn't requested on every update() tick.
+type spotifyUserAuthSource struct {
+	widgetID     string
+	clientID     string
+	clientSecret string
+	store        *spotifyTokenStore
+
+	mu          sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+}
+
+func (s *spotifyUserAuthSource) getToken(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.accessToken != "" && time.Now().Before(s.expiresAt) {
+		return s.accessToken, nil
+	}
+
+	saved, err := s.store.load(s.widgetID)
+	if err != nil {
+		return "", fmt.Errorf("no Spotify authorization on file for this widget, visit its \"connect\" link to grant access: %w", err)
+	}
+
+	tokenResp, err := s.refresh(ctx, saved.RefreshToken)
+	if err != nil {
+		return "", fmt.Errorf("failed to refresh Spotify user token: %w", err)
+	}
+
+	s.accessToken = tokenResp.AccessToken
+	s.expiresAt = time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
+
+	// Spotify doesn't always return a new refresh token on refresh, keep
+	// the previous one in that case.
+	refreshToken := tokenResp.RefreshToken
+	if refreshToken == "" {
+		refreshToken = saved.RefreshToken
+	}
+
+	if err := s.store.save(s.widgetID, spotifyStoredToken{RefreshToken: refreshToken}); err != nil {
+		return "", fmt.Errorf("failed to persist refreshed Spotify token: %w", err)
+	}
+
+	return s.accessToken, nil
+}
+
+func (s *spotifyUserAuthSource) invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.accessToken = ""
+	s.expiresAt = time.Time{}
+}
+
+func (s *spotifyUserAuthSource) refresh(ctx context.Context, refreshToken string) (*SpotifyTokenResponse, error) {
+	data := url.Values{}
+	data.Set("grant_type", "refresh_token")
+	data.Set("refresh_token", refreshToken)
+	data.Set("client_id", s.clientID)
+
+	return spotifyExchangeToken(ctx, s.clientSecret, data)
+}
+
+// exchangeCode trades an authorization code (from the redirect callback) and
+// its matching PKCE verifier for an access + refresh token pair.
+func (s *spotifyUserAuthSource) exchangeCode(ctx context.Context, code, redirectURL, verifier string) error {
+	data := url.Values{}
+	data.Set("grant_type", "authorization_code")
+	data.Set("code", code)
+	data.Set("redirect_uri", redirectURL)
+	data.Set("client_id", s.clientID)
+	data.Set("code_verifier", verifier)
+
+	tokenResp, err := spotifyExchangeToken(ctx, s.clientSecret, data)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.accessToken = tokenResp.AccessToken
+	s.expiresAt = time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
+	s.mu.Unlock()
+
+	return s.store.save(s.widgetID, spotifyStoredToken{RefreshToken: tokenResp.RefreshToken})
+}
+
+// spotifyExchangeToken posts to Spotify's token endpoint. It's shared by the
+// authorization-code exchange and the refresh-token grant since both return
+// the same response shape and only differ in the form fields sent.
+func spotifyExchangeToken(ctx context.Context, clientSecret string, data url.Values) (*SpotifyTokenResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", spotifyTokenURL, strings.NewReader(data.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if clientSecret != "" {
+		credentials := base64.StdEncoding.EncodeToString([]byte(data.Get("client_id") + ":" + clientSecret))
+		req.Header.Set("Authorization", "Basic "+credentials)
+	}
+
+	resp, err := defaultHTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("token request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("token request returned status %d", resp.StatusCode)
+	}
+
+	var tokenResp SpotifyTokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
+		return nil, fmt.Errorf("failed to decode token response: %w", err)
+	}
+
+	return &tokenResp, nil
+}
+
+// spotifyPKCEVerifier generates a PKCE code verifier: 32 random bytes,
+// base64url-encoded without padding, per RFC 7636.
+func spotifyPKCEVerifier() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate PKCE verifier: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// spotifyPKCEChallenge derives the S256 code challenge from a verifier.
+func spotifyPKCEChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// HandleRequest serves the two routes the Spotify provider needs outside of
+// the normal render cycle: the "connect your account" link and the OAuth
+// redirect target Spotify calls back with an authorization code.
+func (s *spotifyProvider) HandleRequest(rw http.ResponseWriter, req *http.Request) {
+	switch {
+	case strings.HasSuffix(req.URL.Path, "/auth/start"):
+		s.handleAuthStart(rw, req)
+	case strings.HasSuffix(req.URL.Path, "/auth/callback"):
+		s.handleAuthCallback(rw, req)
+	default:
+		http.NotFound(rw, req)
+	}
+}
+
+func (s *spotifyProvider) handleAuthStart(rw http.ResponseWriter, req *http.Request) {
+	verifier, err := spotifyPKCEVerifier()
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// The verifier needs to survive the round trip to Spotify and back, so
+	// it's handed to the browser as a short-lived cookie rather than kept
+	// in server-side memory keyed by some session we don't otherwise have.
+	http.SetCookie(rw, &http.Cookie{
+		Name:     "glance-spotify-pkce-" + s.widgetID,
+		Value:    verifier,
+		Path:     "/",
+		MaxAge:   600,
+		HttpOnly: true,
+		Secure:   strings.HasPrefix(s.redirectURL, "https://"),
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	query := url.Values{}
+	query.Set("client_id", s.clientID)
+	query.Set("response_type", "code")
+	query.Set("redirect_uri", s.redirectURL)
+	query.Set("code_challenge_method", "S256")
+	query.Set("code_challenge", spotifyPKCEChallenge(verifier))
+	query.Set("scope", "user-top-read user-read-recently-played user-library-read")
+
+	http.Redirect(rw, req, spotifyAuthorizeURL+"?"+query.Encode(), http.StatusFound)
+}
+
+func (s *spotifyProvider) handleAuthCallback(rw http.ResponseWriter, req *http.Request) {
+	if errParam := req.URL.Query().Get("error"); errParam != "" {
+		http.Error(rw, "Spotify authorization failed: "+errParam, http.StatusBadRequest)
+		return
+	}
+
+	code := req.URL.Query().Get("code")
+	if code == "" {
+		http.Error(rw, "missing code parameter in Spotify callback", http.StatusBadRequest)
+		return
+	}
+
+	cookie, err := req.Cookie("glance-spotify-pkce-" + s.widgetID)
+	if err != nil {
+		http.Error(rw, "missing or expired PKCE verifier, please try connecting again", http.StatusBadRequest)
+		return
+	}
+
+	source, ok := s.tokenSource.(*spotifyUserAuthSource)
+	if !ok {
+		http.Error(rw, "this widget is not configured for user authorization", http.StatusBadRequest)
+		return
+	}
+
+	if err := source.exchangeCode(req.Context(), code, s.redirectURL, cookie.Value); err != nil {
+		http.Error(rw, "failed to complete Spotify authorization: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	http.SetCookie(rw, &http.Cookie{
+		Name:     "glance-spotify-pkce-" + s.widgetID,
+		Value:    "",
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+	})
+
+	fmt.Fprint(rw, "Spotify account connected, you can close this tab.")
+}
@@ -0,0 +1,158 @@
+package glance
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"testing"
+)
+
+func TestSpotifyPKCEChallengeMatchesRFC7636Vector(t *testing.T) {
+	// From RFC 7636 Appendix B.
+	const verifier = "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+	const wantChallenge = "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"
+
+	if got := spotifyPKCEChallenge(verifier); got != wantChallenge {
+		t.Errorf("spotifyPKCEChallenge(%q) = %q, want %q", verifier, got, wantChallenge)
+	}
+}
+
+func TestSpotifyPKCEVerifierIsURLSafe(t *testing.T) {
+	verifier, err := spotifyPKCEVerifier()
+	if err != nil {
+		t.Fatalf("spotifyPKCEVerifier() returned an error: %v", err)
+	}
+
+	if len(verifier) == 0 {
+		t.Fatal("spotifyPKCEVerifier() returned an empty string")
+	}
+
+	if escaped := url.QueryEscape(verifier); escaped != verifier {
+		t.Errorf("verifier %q is not URL-safe: escapes to %q", verifier, escaped)
+	}
+}
+
+// withFakeTokenURL points spotifyTokenURL at server for the duration of the
+// test and restores it afterwards.
+func withFakeTokenURL(t *testing.T, server *httptest.Server) {
+	t.Helper()
+	original := spotifyTokenURL
+	spotifyTokenURL = server.URL
+	t.Cleanup(func() { spotifyTokenURL = original })
+}
+
+func TestExchangeCodePersistsRefreshToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		json.NewEncoder(rw).Encode(SpotifyTokenResponse{
+			AccessToken:  "access-token",
+			ExpiresIn:    3600,
+			RefreshToken: "refresh-token",
+		})
+	}))
+	defer server.Close()
+	withFakeTokenURL(t, server)
+
+	store := newSpotifyTokenStore(filepath.Join(t.TempDir(), "tokens.json"))
+	source := &spotifyUserAuthSource{widgetID: "widget-1", clientID: "client-id", store: store}
+
+	if err := source.exchangeCode(context.Background(), "auth-code", "https://example.com/callback", "verifier"); err != nil {
+		t.Fatalf("exchangeCode() returned an error: %v", err)
+	}
+
+	saved, err := store.load("widget-1")
+	if err != nil {
+		t.Fatalf("store.load() returned an error: %v", err)
+	}
+	if saved.RefreshToken != "refresh-token" {
+		t.Errorf("stored refresh token = %q, want %q", saved.RefreshToken, "refresh-token")
+	}
+}
+
+func TestExchangeCodeReturnsErrorOnNon200(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+	withFakeTokenURL(t, server)
+
+	store := newSpotifyTokenStore(filepath.Join(t.TempDir(), "tokens.json"))
+	source := &spotifyUserAuthSource{widgetID: "widget-1", clientID: "client-id", store: store}
+
+	if err := source.exchangeCode(context.Background(), "auth-code", "https://example.com/callback", "verifier"); err == nil {
+		t.Fatal("exchangeCode() returned nil error for a non-200 response")
+	}
+}
+
+func TestRefreshKeepsPreviousTokenWhenSpotifyOmitsANewOne(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		// Spotify doesn't always return a new refresh_token on refresh.
+		json.NewEncoder(rw).Encode(SpotifyTokenResponse{AccessToken: "new-access-token", ExpiresIn: 3600})
+	}))
+	defer server.Close()
+	withFakeTokenURL(t, server)
+
+	store := newSpotifyTokenStore(filepath.Join(t.TempDir(), "tokens.json"))
+	if err := store.save("widget-1", spotifyStoredToken{RefreshToken: "original-refresh-token"}); err != nil {
+		t.Fatalf("store.save() returned an error: %v", err)
+	}
+
+	source := &spotifyUserAuthSource{widgetID: "widget-1", clientID: "client-id", store: store}
+
+	token, err := source.getToken(context.Background())
+	if err != nil {
+		t.Fatalf("getToken() returned an error: %v", err)
+	}
+	if token != "new-access-token" {
+		t.Errorf("getToken() = %q, want %q", token, "new-access-token")
+	}
+
+	saved, err := store.load("widget-1")
+	if err != nil {
+		t.Fatalf("store.load() returned an error: %v", err)
+	}
+	if saved.RefreshToken != "original-refresh-token" {
+		t.Errorf("stored refresh token = %q, want the original to be kept: %q", saved.RefreshToken, "original-refresh-token")
+	}
+}
+
+func TestHandleAuthCallbackRejectsSpotifyErrorParam(t *testing.T) {
+	provider := &spotifyProvider{widgetID: "widget-1"}
+
+	req := httptest.NewRequest(http.MethodGet, "/auth/callback?error=access_denied", nil)
+	rw := httptest.NewRecorder()
+
+	provider.handleAuthCallback(rw, req)
+
+	if rw.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d", rw.Code, http.StatusBadRequest)
+	}
+}
+
+func TestHandleAuthCallbackRejectsMissingCode(t *testing.T) {
+	provider := &spotifyProvider{widgetID: "widget-1"}
+
+	req := httptest.NewRequest(http.MethodGet, "/auth/callback", nil)
+	rw := httptest.NewRecorder()
+
+	provider.handleAuthCallback(rw, req)
+
+	if rw.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d", rw.Code, http.StatusBadRequest)
+	}
+}
+
+func TestHandleAuthCallbackRejectsMissingPKCECookie(t *testing.T) {
+	provider := &spotifyProvider{widgetID: "widget-1"}
+
+	req := httptest.NewRequest(http.MethodGet, "/auth/callback?code=auth-code", nil)
+	rw := httptest.NewRecorder()
+
+	provider.handleAuthCallback(rw, req)
+
+	if rw.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d", rw.Code, http.StatusBadRequest)
+	}
+}
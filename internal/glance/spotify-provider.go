@@ -0,0 +1,723 @@
+package glance
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MusicAlbum, MusicArtist, MusicImage, MusicPlaylist and MusicTrack are the
+// provider-neutral models a musicWidget's template renders, regardless of
+// which musicProvider populated them. Their JSON tags
+// mirror Spotify's API shapes exactly since spotifyProvider decodes
+// responses directly into them; other providers (e.g. lastfmProvider) adapt
+// their own response shapes into these same types instead of inventing
+// parallel ones.
+type MusicAlbum struct {
+	ID           string        `json:"id"`
+	Name         string        `json:"name"`
+	AlbumType    string        `json:"album_type"`
+	Artists      []MusicArtist `json:"artists"`
+	Images       []MusicImage  `json:"images"`
+	ReleaseDate  string        `json:"release_date"`
+	TotalTracks  int           `json:"total_tracks"`
+	ExternalUrls struct {
+		Spotify string `json:"spotify"`
+	} `json:"external_urls"`
+}
+
+type MusicArtist struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type MusicImage struct {
+	Height int    `json:"height"`
+	Width  int    `json:"width"`
+	URL    string `json:"url"`
+}
+
+type MusicPlaylist struct {
+	ID          string       `json:"id"`
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	Images      []MusicImage `json:"images"`
+	Owner       struct {
+		DisplayName string `json:"display_name"`
+	} `json:"owner"`
+	Tracks struct {
+		Total int `json:"total"`
+	} `json:"tracks"`
+	ExternalUrls struct {
+		Spotify string `json:"spotify"`
+	} `json:"external_urls"`
+}
+
+type MusicTrack struct {
+	ID           string        `json:"id"`
+	Name         string        `json:"name"`
+	Artists      []MusicArtist `json:"artists"`
+	DurationMs   int           `json:"duration_ms"`
+	ExternalUrls struct {
+		Spotify string `json:"spotify"`
+	} `json:"external_urls"`
+}
+
+// API Response structs (what Spotify sends us)
+type SpotifyNewReleasesResponse struct {
+	Albums struct {
+		Href   string       `json:"href"`
+		Limit  int          `json:"limit"`
+		Offset int          `json:"offset"`
+		Total  int          `json:"total"`
+		Items  []MusicAlbum `json:"items"`
+	} `json:"albums"`
+}
+
+// Response envelopes for the extra content types. Spotify nests results
+// differently depending on the endpoint, these mirror that shape exactly so
+// the JSON can be decoded directly into them.
+
+type SpotifySearchResponse struct {
+	Albums struct {
+		Items []MusicAlbum `json:"items"`
+	} `json:"albums"`
+	Artists struct {
+		Items []MusicArtist `json:"items"`
+	} `json:"artists"`
+	Playlists struct {
+		Items []MusicPlaylist `json:"items"`
+	} `json:"playlists"`
+	Tracks struct {
+		Items []MusicTrack `json:"items"`
+	} `json:"tracks"`
+}
+
+type SpotifyFeaturedPlaylistsResponse struct {
+	Playlists struct {
+		Items []MusicPlaylist `json:"items"`
+	} `json:"playlists"`
+}
+
+type SpotifyCategoryPlaylistsResponse struct {
+	Playlists struct {
+		Items []MusicPlaylist `json:"items"`
+	} `json:"playlists"`
+}
+
+type SpotifyArtistTopTracksResponse struct {
+	Tracks []MusicTrack `json:"tracks"`
+}
+
+type SpotifyAlbumTracksResponse struct {
+	Items []MusicTrack `json:"items"`
+}
+
+type SpotifyPlaylistTracksResponse struct {
+	Items []struct {
+		Track MusicTrack `json:"track"`
+	} `json:"items"`
+}
+
+type SpotifyTokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int    `json:"expires_in"`
+	RefreshToken string `json:"refresh_token"`
+	Scope        string `json:"scope"`
+}
+
+// spotifyTokenSource abstracts how the provider obtains a bearer token for
+// Spotify API calls. Content types that only need catalog data (e.g.
+// new-releases) use client-credentials; content types that expose a
+// specific user's library or listening history need a user token obtained
+// through the Authorization Code + PKCE flow and persisted across restarts.
+type spotifyTokenSource interface {
+	getToken(ctx context.Context) (string, error)
+	// invalidate discards any cached access token so the next getToken
+	// call is forced to fetch (or refresh) a fresh one.
+	invalidate()
+}
+
+// spotifyClientCredentialsSource implements spotifyTokenSource using the
+// app-only client-credentials grant. Tokens are shared across every widget
+// with the same credentials via spotifySharedTokenCache, a new one is only
+// requested once the cached one is about to expire.
+type spotifyClientCredentialsSource struct {
+	clientID     string
+	clientSecret string
+	cache        *spotifyTokenCache
+}
+
+func (s *spotifyClientCredentialsSource) getToken(ctx context.Context) (string, error) {
+	if token, ok := s.cache.get(s.clientID, s.clientSecret); ok {
+		return token, nil
+	}
+
+	credentials := base64.StdEncoding.EncodeToString(
+		[]byte(s.clientID + ":" + s.clientSecret),
+	)
+
+	data := url.Values{}
+	data.Set("grant_type", "client_credentials")
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"POST",
+		"https://accounts.spotify.com/api/token",
+		strings.NewReader(data.Encode()),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to create token request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Authorization", "Basic "+credentials)
+
+	resp, err := defaultHTTPClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("token request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("token request returned status %d", resp.StatusCode)
+	}
+
+	var tokenResp SpotifyTokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
+		return "", fmt.Errorf("failed to decode token response: %w", err)
+	}
+
+	s.cache.set(s.clientID, s.clientSecret, tokenResp.AccessToken, tokenResp.ExpiresIn)
+
+	return tokenResp.AccessToken, nil
+}
+
+func (s *spotifyClientCredentialsSource) invalidate() {
+	s.cache.invalidate(s.clientID, s.clientSecret)
+}
+
+// spotifyProvider implements musicProvider, musicProviderExtra and
+// musicProviderRequestHandler against the real Spotify Web API.
+type spotifyProvider struct {
+	clientID     string
+	clientSecret string
+	redirectURL  string
+	widgetID     string
+
+	tokenSource spotifyTokenSource
+}
+
+// spotifyContentTypeNeedsUserAuth reports whether contentType reads a
+// specific user's library or listening history, which Spotify only exposes
+// through a user token (Authorization Code + PKCE) rather than the simpler
+// app-only client-credentials grant.
+func spotifyContentTypeNeedsUserAuth(contentType string) bool {
+	switch contentType {
+	case musicContentTopTracks, musicContentTopArtists, musicContentRecentlyPlayed, musicContentSavedAlbums:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *spotifyProvider) Init(widgetID, contentType string) error {
+	if s.clientID == "" {
+		return fmt.Errorf("client-id is required")
+	}
+	if s.clientSecret == "" {
+		return fmt.Errorf("client-secret is required")
+	}
+
+	s.widgetID = widgetID
+
+	// Content types that read a specific user's library/history need a
+	// user token obtained via the Authorization Code + PKCE flow, everything
+	// else can use the simpler client-credentials grant.
+	if spotifyContentTypeNeedsUserAuth(contentType) {
+		if s.redirectURL == "" {
+			return fmt.Errorf("redirect-url is required for content-type %q", contentType)
+		}
+		if s.widgetID == "" {
+			s.widgetID = spotifyDefaultWidgetID(s.clientID, s.redirectURL)
+		}
+		s.tokenSource = &spotifyUserAuthSource{
+			widgetID:     s.widgetID,
+			clientID:     s.clientID,
+			clientSecret: s.clientSecret,
+			store:        spotifyDefaultTokenStore,
+		}
+	} else {
+		s.tokenSource = &spotifyClientCredentialsSource{
+			clientID:     s.clientID,
+			clientSecret: s.clientSecret,
+			cache:        spotifySharedTokenCache,
+		}
+	}
+
+	return nil
+}
+
+func (s *spotifyProvider) getAccessToken(ctx context.Context) (string, error) {
+	return s.tokenSource.getToken(ctx)
+}
+
+func (s *spotifyProvider) FetchNewReleases(ctx context.Context, opts musicFetchOptions) ([]any, error) {
+	token, err := s.getAccessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apiURL := fmt.Sprintf(
+		"https://api.spotify.com/v1/browse/new-releases?limit=%d&country=%s",
+		opts.Limit,
+		opts.Country,
+	)
+
+	var apiResp SpotifyNewReleasesResponse
+	if err := s.spotifyGet(ctx, token, apiURL, &apiResp); err != nil {
+		return nil, err
+	}
+
+	return albumsToItems(apiResp.Albums.Items), nil
+}
+
+// spotifyStatusError is returned by spotifyAPIGet for non-200 responses so
+// callers can tell a stale token (401) or rate limiting (429) apart from
+// other failures and react accordingly instead of just giving up.
+type spotifyStatusError struct {
+	status     int
+	retryAfter time.Duration
+}
+
+func (e *spotifyStatusError) Error() string {
+	return fmt.Sprintf("API returned status %d", e.status)
+}
+
+// spotifyAPIGet is a shared low-level helper - it builds a bearer-authed GET
+// request and decodes the JSON response, used by every endpoint below.
+func spotifyAPIGet(ctx context.Context, token, apiURL string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", apiURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create API request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := defaultHTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("API request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return &spotifyStatusError{
+			status:     resp.StatusCode,
+			retryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+		}
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode API response: %w", err)
+	}
+
+	return nil
+}
+
+// spotifyMaxRetryAfter bounds how long we'll wait on a 429 before giving up
+// and retrying anyway - Spotify can ask for minutes, which is too long to
+// block a single dashboard update for.
+const spotifyMaxRetryAfter = 10 * time.Second
+
+func parseRetryAfter(header string) time.Duration {
+	seconds, err := strconv.Atoi(header)
+	if err != nil || seconds <= 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// spotifyGet wraps spotifyAPIGet with the two retries Spotify's own client
+// libraries handle via their oauth2 transports: a 401 means the token
+// (cached or freshly refreshed) went stale mid-flight, so it's invalidated
+// and the request retried once with a new one; a 429 means we're rate
+// limited, so we honor Retry-After (capped at spotifyMaxRetryAfter) and
+// retry once.
+func (s *spotifyProvider) spotifyGet(ctx context.Context, token, apiURL string, out any) error {
+	err := spotifyAPIGet(ctx, token, apiURL, out)
+
+	var statusErr *spotifyStatusError
+	if !errors.As(err, &statusErr) {
+		return err
+	}
+
+	switch statusErr.status {
+	case http.StatusUnauthorized:
+		s.tokenSource.invalidate()
+
+		newToken, tokenErr := s.getAccessToken(ctx)
+		if tokenErr != nil {
+			return fmt.Errorf("refreshing token after 401: %w", tokenErr)
+		}
+
+		return spotifyAPIGet(ctx, newToken, apiURL, out)
+
+	case http.StatusTooManyRequests:
+		wait := statusErr.retryAfter
+		if wait > spotifyMaxRetryAfter {
+			wait = spotifyMaxRetryAfter
+		}
+
+		if wait > 0 {
+			timer := time.NewTimer(wait)
+			defer timer.Stop()
+
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		return spotifyAPIGet(ctx, token, apiURL, out)
+
+	default:
+		return err
+	}
+}
+
+func (s *spotifyProvider) SearchAlbum(ctx context.Context, opts musicFetchOptions) ([]any, error) {
+	if opts.Query == "" {
+		return nil, fmt.Errorf("query is required for content-type %q", musicContentSearch)
+	}
+
+	token, err := s.getAccessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apiURL := fmt.Sprintf(
+		"https://api.spotify.com/v1/search?q=%s&type=album&limit=%d",
+		url.QueryEscape(opts.Query),
+		opts.Limit,
+	)
+
+	var apiResp SpotifySearchResponse
+	if err := s.spotifyGet(ctx, token, apiURL, &apiResp); err != nil {
+		return nil, fmt.Errorf("search failed: %w", err)
+	}
+
+	return albumsToItems(spotifyRankAlbumsByQuery(apiResp.Albums.Items, opts.Query)), nil
+}
+
+func (s *spotifyProvider) FetchFeaturedPlaylists(ctx context.Context, opts musicFetchOptions) ([]any, error) {
+	token, err := s.getAccessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apiURL := fmt.Sprintf(
+		"https://api.spotify.com/v1/browse/featured-playlists?limit=%d&country=%s",
+		opts.Limit,
+		opts.Country,
+	)
+
+	var apiResp SpotifyFeaturedPlaylistsResponse
+	if err := s.spotifyGet(ctx, token, apiURL, &apiResp); err != nil {
+		return nil, fmt.Errorf("fetching featured playlists failed: %w", err)
+	}
+
+	return playlistsToItems(apiResp.Playlists.Items), nil
+}
+
+func (s *spotifyProvider) FetchCategoryPlaylists(ctx context.Context, opts musicFetchOptions) ([]any, error) {
+	if opts.Category == "" {
+		return nil, fmt.Errorf("category is required for content-type %q", musicContentCategoryPlaylist)
+	}
+
+	token, err := s.getAccessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apiURL := fmt.Sprintf(
+		"https://api.spotify.com/v1/browse/categories/%s/playlists?limit=%d&country=%s",
+		url.PathEscape(opts.Category),
+		opts.Limit,
+		opts.Country,
+	)
+
+	var apiResp SpotifyCategoryPlaylistsResponse
+	if err := s.spotifyGet(ctx, token, apiURL, &apiResp); err != nil {
+		return nil, fmt.Errorf("fetching category playlists failed: %w", err)
+	}
+
+	return playlistsToItems(apiResp.Playlists.Items), nil
+}
+
+// resolveArtistID returns opts.ArtistID if set, otherwise resolves it by
+// searching for opts.Query and taking the best-scoring artist match.
+func (s *spotifyProvider) resolveArtistID(ctx context.Context, token string, opts musicFetchOptions) (string, error) {
+	if opts.ArtistID != "" {
+		return opts.ArtistID, nil
+	}
+
+	if opts.Query == "" {
+		return "", fmt.Errorf("artist-id or query is required for content-type %q", musicContentArtistTopTracks)
+	}
+
+	apiURL := fmt.Sprintf(
+		"https://api.spotify.com/v1/search?q=%s&type=artist&limit=10",
+		url.QueryEscape(opts.Query),
+	)
+
+	var apiResp SpotifySearchResponse
+	if err := s.spotifyGet(ctx, token, apiURL, &apiResp); err != nil {
+		return "", fmt.Errorf("resolving artist failed: %w", err)
+	}
+
+	ranked := spotifyRankArtistsByQuery(apiResp.Artists.Items, opts.Query)
+	if len(ranked) == 0 {
+		return "", fmt.Errorf("no artist found matching %q", opts.Query)
+	}
+
+	return ranked[0].ID, nil
+}
+
+func (s *spotifyProvider) FetchArtistTopTracks(ctx context.Context, opts musicFetchOptions) ([]any, error) {
+	token, err := s.getAccessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	artistID, err := s.resolveArtistID(ctx, token, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	apiURL := fmt.Sprintf(
+		"https://api.spotify.com/v1/artists/%s/top-tracks?market=%s",
+		url.PathEscape(artistID),
+		opts.Country,
+	)
+
+	var apiResp SpotifyArtistTopTracksResponse
+	if err := s.spotifyGet(ctx, token, apiURL, &apiResp); err != nil {
+		return nil, fmt.Errorf("fetching artist top tracks failed: %w", err)
+	}
+
+	return tracksToItems(apiResp.Tracks), nil
+}
+
+func (s *spotifyProvider) FetchAlbumTracks(ctx context.Context, opts musicFetchOptions) ([]any, error) {
+	if opts.AlbumID == "" {
+		return nil, fmt.Errorf("album-id is required for content-type %q", musicContentAlbumTracks)
+	}
+
+	token, err := s.getAccessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apiURL := fmt.Sprintf(
+		"https://api.spotify.com/v1/albums/%s/tracks?limit=%d",
+		url.PathEscape(opts.AlbumID),
+		opts.Limit,
+	)
+
+	var apiResp SpotifyAlbumTracksResponse
+	if err := s.spotifyGet(ctx, token, apiURL, &apiResp); err != nil {
+		return nil, fmt.Errorf("fetching album tracks failed: %w", err)
+	}
+
+	return tracksToItems(apiResp.Items), nil
+}
+
+func (s *spotifyProvider) FetchPlaylistTracks(ctx context.Context, opts musicFetchOptions, playlistID string) ([]any, error) {
+	if playlistID == "" {
+		return nil, fmt.Errorf(`content-type must be "playlist:<playlist-id>"`)
+	}
+
+	token, err := s.getAccessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apiURL := fmt.Sprintf(
+		"https://api.spotify.com/v1/playlists/%s/tracks?limit=%d",
+		url.PathEscape(playlistID),
+		opts.Limit,
+	)
+
+	var apiResp SpotifyPlaylistTracksResponse
+	if err := s.spotifyGet(ctx, token, apiURL, &apiResp); err != nil {
+		return nil, fmt.Errorf("fetching playlist tracks failed: %w", err)
+	}
+
+	tracks := make([]MusicTrack, 0, len(apiResp.Items))
+	for _, item := range apiResp.Items {
+		tracks = append(tracks, item.Track)
+	}
+
+	return tracksToItems(tracks), nil
+}
+
+func (s *spotifyProvider) FetchTopTracks(ctx context.Context, opts musicFetchOptions) ([]any, error) {
+	token, err := s.getAccessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apiURL := fmt.Sprintf("https://api.spotify.com/v1/me/top/tracks?limit=%d", opts.Limit)
+
+	// Unlike /artists/{id}/top-tracks, which nests under "tracks", this is a
+	// paging object keyed "items" - same shape as /me/top/artists below.
+	var apiResp struct {
+		Items []MusicTrack `json:"items"`
+	}
+	if err := s.spotifyGet(ctx, token, apiURL, &apiResp); err != nil {
+		return nil, fmt.Errorf("fetching top tracks failed: %w", err)
+	}
+
+	return tracksToItems(apiResp.Items), nil
+}
+
+func (s *spotifyProvider) FetchTopArtists(ctx context.Context, opts musicFetchOptions) ([]any, error) {
+	token, err := s.getAccessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apiURL := fmt.Sprintf("https://api.spotify.com/v1/me/top/artists?limit=%d", opts.Limit)
+
+	var apiResp struct {
+		Items []MusicArtist `json:"items"`
+	}
+	if err := s.spotifyGet(ctx, token, apiURL, &apiResp); err != nil {
+		return nil, fmt.Errorf("fetching top artists failed: %w", err)
+	}
+
+	items := make([]any, 0, len(apiResp.Items))
+	for _, artist := range apiResp.Items {
+		items = append(items, artist)
+	}
+
+	return items, nil
+}
+
+func (s *spotifyProvider) FetchRecentlyPlayed(ctx context.Context, opts musicFetchOptions) ([]any, error) {
+	token, err := s.getAccessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apiURL := fmt.Sprintf("https://api.spotify.com/v1/me/player/recently-played?limit=%d", opts.Limit)
+
+	var apiResp struct {
+		Items []struct {
+			Track MusicTrack `json:"track"`
+		} `json:"items"`
+	}
+	if err := s.spotifyGet(ctx, token, apiURL, &apiResp); err != nil {
+		return nil, fmt.Errorf("fetching recently played tracks failed: %w", err)
+	}
+
+	tracks := make([]MusicTrack, 0, len(apiResp.Items))
+	for _, item := range apiResp.Items {
+		tracks = append(tracks, item.Track)
+	}
+
+	return tracksToItems(tracks), nil
+}
+
+func (s *spotifyProvider) FetchSavedAlbums(ctx context.Context, opts musicFetchOptions) ([]any, error) {
+	token, err := s.getAccessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apiURL := fmt.Sprintf("https://api.spotify.com/v1/me/albums?limit=%d", opts.Limit)
+
+	var apiResp struct {
+		Items []struct {
+			Album MusicAlbum `json:"album"`
+		} `json:"items"`
+	}
+	if err := s.spotifyGet(ctx, token, apiURL, &apiResp); err != nil {
+		return nil, fmt.Errorf("fetching saved albums failed: %w", err)
+	}
+
+	albums := make([]MusicAlbum, 0, len(apiResp.Items))
+	for _, item := range apiResp.Items {
+		albums = append(albums, item.Album)
+	}
+
+	return albumsToItems(albums), nil
+}
+
+// albumsToItems, playlistsToItems and tracksToItems adapt the typed slices
+// returned by each endpoint into the []any shape Items expects so templates
+// can range over a single field regardless of content type.
+func albumsToItems(albums []MusicAlbum) []any {
+	items := make([]any, 0, len(albums))
+	for _, album := range albums {
+		items = append(items, album)
+	}
+	return items
+}
+
+func playlistsToItems(playlists []MusicPlaylist) []any {
+	items := make([]any, 0, len(playlists))
+	for _, playlist := range playlists {
+		items = append(items, playlist)
+	}
+	return items
+}
+
+func tracksToItems(tracks []MusicTrack) []any {
+	items := make([]any, 0, len(tracks))
+	for _, track := range tracks {
+		items = append(items, track)
+	}
+	return items
+}
+
+// Helper method to get best image size
+func (album MusicAlbum) GetImageURL(preferredSize int) string {
+	if len(album.Images) == 0 {
+		return "" // No images available
+	}
+
+	// Find the image closest to our preferred size
+	bestImage := album.Images[0] // Start with first image
+	bestDiff := abs(bestImage.Width - preferredSize)
+
+	// Check all images and find the one closest to preferred size
+	for _, img := range album.Images {
+		diff := abs(img.Width - preferredSize)
+		if diff < bestDiff {
+			bestImage = img
+			bestDiff = diff
+		}
+	}
+
+	return bestImage.URL
+}
+
+// Helper method to get main artist name
+func (album MusicAlbum) GetMainArtist() string {
+	if len(album.Artists) == 0 {
+		return "Unknown Artist"
+	}
+	// Return the first artist (usually the main one)
+	return album.Artists[0].Name
+}
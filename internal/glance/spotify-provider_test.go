@@ -0,0 +1,99 @@
+package glance
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// fakeTokenSource is a minimal spotifyTokenSource for exercising spotifyGet's
+// retry behavior without going through the real OAuth flows.
+type fakeTokenSource struct {
+	token           string
+	invalidateCalls int
+}
+
+func (f *fakeTokenSource) getToken(ctx context.Context) (string, error) {
+	return f.token, nil
+}
+
+func (f *fakeTokenSource) invalidate() {
+	f.invalidateCalls++
+	f.token = f.token + "-refreshed"
+}
+
+func TestSpotifyGetRetriesOnceOn401(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		requests++
+		if requests == 1 {
+			rw.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		rw.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	tokenSource := &fakeTokenSource{token: "stale-token"}
+	provider := &spotifyProvider{tokenSource: tokenSource}
+
+	var out struct{}
+	err := provider.spotifyGet(context.Background(), tokenSource.token, server.URL, &out)
+	if err != nil {
+		t.Fatalf("spotifyGet returned an error: %v", err)
+	}
+
+	if requests != 2 {
+		t.Errorf("expected 2 requests (original + retry), got %d", requests)
+	}
+	if tokenSource.invalidateCalls != 1 {
+		t.Errorf("expected invalidate() to be called once, got %d", tokenSource.invalidateCalls)
+	}
+}
+
+func TestSpotifyGetRetriesOnceOn429(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		requests++
+		if requests == 1 {
+			rw.Header().Set("Retry-After", "0")
+			rw.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		rw.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	tokenSource := &fakeTokenSource{token: "token"}
+	provider := &spotifyProvider{tokenSource: tokenSource}
+
+	var out struct{}
+	err := provider.spotifyGet(context.Background(), tokenSource.token, server.URL, &out)
+	if err != nil {
+		t.Fatalf("spotifyGet returned an error: %v", err)
+	}
+
+	if requests != 2 {
+		t.Errorf("expected 2 requests (original + retry), got %d", requests)
+	}
+	if tokenSource.invalidateCalls != 0 {
+		t.Errorf("expected invalidate() not to be called on 429, got %d calls", tokenSource.invalidateCalls)
+	}
+}
+
+func TestSpotifyGetGivesUpAfterSecondFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	tokenSource := &fakeTokenSource{token: "token"}
+	provider := &spotifyProvider{tokenSource: tokenSource}
+
+	var out struct{}
+	err := provider.spotifyGet(context.Background(), tokenSource.token, server.URL, &out)
+	if err == nil {
+		t.Fatal("expected spotifyGet to return an error after a repeated 401, got nil")
+	}
+}
@@ -0,0 +1,194 @@
+package glance
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// winklerPrefixWeight is the standard Winkler prefix scaling factor, often
+// called p in descriptions of the algorithm.
+const winklerPrefixWeight = 0.1
+
+// winklerMaxPrefixLen caps how many leading characters count towards the
+// Winkler prefix bonus.
+const winklerMaxPrefixLen = 4
+
+// jaroWinklerSimilarity scores how similar s1 and s2 are on a 0-1 scale. It's
+// used to rank Spotify search results, since the best match for a user's
+// query isn't always the first item the API returns.
+func jaroWinklerSimilarity(s1, s2 string) float64 {
+	s1, s2 = normalizeForSimilarity(s1), normalizeForSimilarity(s2)
+
+	jaro := jaroSimilarity(s1, s2)
+	if jaro == 0 {
+		return 0
+	}
+
+	prefixLen := commonPrefixLen(s1, s2)
+	if prefixLen > winklerMaxPrefixLen {
+		prefixLen = winklerMaxPrefixLen
+	}
+
+	return jaro + float64(prefixLen)*winklerPrefixWeight*(1-jaro)
+}
+
+// jaroSimilarity implements the Jaro distance: find matching characters
+// within a window of max(len1,len2)/2-1, count transpositions among them,
+// then combine into (m/len1 + m/len2 + (m-t)/m) / 3.
+func jaroSimilarity(s1, s2 string) float64 {
+	r1, r2 := []rune(s1), []rune(s2)
+	len1, len2 := len(r1), len(r2)
+
+	if len1 == 0 || len2 == 0 {
+		if len1 == len2 {
+			return 1
+		}
+		return 0
+	}
+
+	matchWindow := len1
+	if len2 > matchWindow {
+		matchWindow = len2
+	}
+	matchWindow = matchWindow/2 - 1
+	if matchWindow < 0 {
+		matchWindow = 0
+	}
+
+	s1Matches := make([]bool, len1)
+	s2Matches := make([]bool, len2)
+	matches := 0
+
+	for i := range r1 {
+		start := i - matchWindow
+		if start < 0 {
+			start = 0
+		}
+		end := i + matchWindow + 1
+		if end > len2 {
+			end = len2
+		}
+
+		for j := start; j < end; j++ {
+			if s2Matches[j] || r1[i] != r2[j] {
+				continue
+			}
+			s1Matches[i] = true
+			s2Matches[j] = true
+			matches++
+			break
+		}
+	}
+
+	if matches == 0 {
+		return 0
+	}
+
+	transpositions := 0
+	k := 0
+	for i := range r1 {
+		if !s1Matches[i] {
+			continue
+		}
+		for !s2Matches[k] {
+			k++
+		}
+		if r1[i] != r2[k] {
+			transpositions++
+		}
+		k++
+	}
+	transpositions /= 2
+
+	m := float64(matches)
+	return (m/float64(len1) + m/float64(len2) + (m-float64(transpositions))/m) / 3
+}
+
+func commonPrefixLen(s1, s2 string) int {
+	r1, r2 := []rune(s1), []rune(s2)
+
+	limit := len(r1)
+	if len(r2) < limit {
+		limit = len(r2)
+	}
+
+	i := 0
+	for i < limit && r1[i] == r2[i] {
+		i++
+	}
+	return i
+}
+
+// normalizeForSimilarity lowercases and strips punctuation so comparisons
+// aren't thrown off by casing or stray symbols in Spotify's titles.
+func normalizeForSimilarity(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsNumber(r) || unicode.IsSpace(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// spotifyAlbumMatchScore scores an album against query, combining the album
+// name with its primary artist so "Spotify: A Tribute" doesn't outrank
+// "Tribute" by the artist the user actually searched for.
+func spotifyAlbumMatchScore(album MusicAlbum, query string) float64 {
+	name := album.Name
+	if len(album.Artists) > 0 {
+		name = name + " " + album.Artists[0].Name
+	}
+	return jaroWinklerSimilarity(name, query)
+}
+
+// spotifyRankAlbumsByQuery reorders albums so the best match for query sorts
+// first.
+func spotifyRankAlbumsByQuery(albums []MusicAlbum, query string) []MusicAlbum {
+	type scoredAlbum struct {
+		album MusicAlbum
+		score float64
+	}
+
+	scored := make([]scoredAlbum, len(albums))
+	for i, album := range albums {
+		scored[i] = scoredAlbum{album: album, score: spotifyAlbumMatchScore(album, query)}
+	}
+
+	sort.SliceStable(scored, func(i, j int) bool {
+		return scored[i].score > scored[j].score
+	})
+
+	ranked := make([]MusicAlbum, len(scored))
+	for i, s := range scored {
+		ranked[i] = s.album
+	}
+
+	return ranked
+}
+
+// spotifyRankArtistsByQuery reorders artists so the best match for query
+// sorts first.
+func spotifyRankArtistsByQuery(artists []MusicArtist, query string) []MusicArtist {
+	type scoredArtist struct {
+		artist MusicArtist
+		score  float64
+	}
+
+	scored := make([]scoredArtist, len(artists))
+	for i, artist := range artists {
+		scored[i] = scoredArtist{artist: artist, score: jaroWinklerSimilarity(artist.Name, query)}
+	}
+
+	sort.SliceStable(scored, func(i, j int) bool {
+		return scored[i].score > scored[j].score
+	})
+
+	ranked := make([]MusicArtist, len(scored))
+	for i, s := range scored {
+		ranked[i] = s.artist
+	}
+
+	return ranked
+}
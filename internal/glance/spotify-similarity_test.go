@@ -0,0 +1,49 @@
+package glance
+
+import "testing"
+
+func TestJaroWinklerSimilarity(t *testing.T) {
+	tests := []struct {
+		name     string
+		s1, s2   string
+		min, max float64
+	}{
+		{"identical strings score 1", "Sigur Ros", "Sigur Ros", 1, 1},
+		{"empty strings score 1", "", "", 1, 1},
+		{"one empty string scores 0", "Sigur Ros", "", 0, 0},
+		{"completely different strings score low", "abc", "xyz", 0, 0.01},
+		{"close match scores high", "Motley Crue", "motley crue", 0.99, 1},
+		{"shared prefix lifts score above 0.9", "Bjork", "Bjorn", 0.9, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := jaroWinklerSimilarity(tt.s1, tt.s2)
+			if got < tt.min || got > tt.max {
+				t.Errorf("jaroWinklerSimilarity(%q, %q) = %v, want between %v and %v", tt.s1, tt.s2, got, tt.min, tt.max)
+			}
+		})
+	}
+}
+
+// commonPrefixLen must count shared runes, not shared bytes - "ä" (0xC3 0xA4)
+// and "å" (0xC3 0xA5) share a leading byte but are different runes, so a
+// byte-wise compare would overcount the prefix of non-ASCII names.
+func TestCommonPrefixLenCountsRunesNotBytes(t *testing.T) {
+	got := commonPrefixLen("xäYYYYYY", "xåZZZZZZ")
+	if got != 1 {
+		t.Errorf("commonPrefixLen = %d, want 1", got)
+	}
+}
+
+func TestSpotifyRankAlbumsByQuery(t *testing.T) {
+	albums := []MusicAlbum{
+		{Name: "Greatest Hits", Artists: []MusicArtist{{Name: "Various Artists"}}},
+		{Name: "Sigur Ros", Artists: []MusicArtist{{Name: "Sigur Ros"}}},
+	}
+
+	ranked := spotifyRankAlbumsByQuery(albums, "Sigur Ros")
+	if ranked[0].Name != "Sigur Ros" {
+		t.Errorf("expected %q to rank first, got %q", "Sigur Ros", ranked[0].Name)
+	}
+}
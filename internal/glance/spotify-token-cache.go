@@ -0,0 +1,85 @@
+package glance
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// spotifyTokenExpiryBuffer is how far ahead of the token's actual expiry we
+// stop serving it from cache, so an in-flight request doesn't get a token
+// that dies before the response comes back.
+const spotifyTokenExpiryBuffer = 60 * time.Second
+
+// spotifyCachedToken is one entry in a spotifyTokenCache.
+type spotifyCachedToken struct {
+	accessToken string
+	expiresAt   time.Time
+}
+
+// spotifyTokenCache caches client-credentials tokens across every
+// spotifyProvider configured with the same credentials, so a dashboard with
+// several Spotify widgets doesn't fetch a fresh token on every update()
+// tick and risk getting rate limited. Entries are keyed by a hash of
+// ClientID+ClientSecret rather than the credentials themselves.
+//
+// now is overridable so tests can inject a fake clock instead of sleeping
+// real seconds to exercise expiry.
+type spotifyTokenCache struct {
+	mu      sync.Mutex
+	now     func() time.Time
+	entries map[string]spotifyCachedToken
+}
+
+// spotifySharedTokenCache is the cache spotifyProvider.Init gives every
+// spotifyClientCredentialsSource; tests build their own with
+// newSpotifyTokenCache.
+var spotifySharedTokenCache = newSpotifyTokenCache()
+
+func newSpotifyTokenCache() *spotifyTokenCache {
+	return &spotifyTokenCache{
+		now:     time.Now,
+		entries: make(map[string]spotifyCachedToken),
+	}
+}
+
+func spotifyCredentialsCacheKey(clientID, clientSecret string) string {
+	sum := sha256.Sum256([]byte(clientID + ":" + clientSecret))
+	return fmt.Sprintf("%x", sum)
+}
+
+// get returns the cached token for the given credentials, if one exists and
+// isn't within spotifyTokenExpiryBuffer of expiring.
+func (c *spotifyTokenCache) get(clientID, clientSecret string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[spotifyCredentialsCacheKey(clientID, clientSecret)]
+	if !ok || !c.now().Before(entry.expiresAt) {
+		return "", false
+	}
+
+	return entry.accessToken, true
+}
+
+// set stores accessToken, expiring it expiresIn seconds from now minus the
+// safety buffer.
+func (c *spotifyTokenCache) set(clientID, clientSecret, accessToken string, expiresIn int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[spotifyCredentialsCacheKey(clientID, clientSecret)] = spotifyCachedToken{
+		accessToken: accessToken,
+		expiresAt:   c.now().Add(time.Duration(expiresIn)*time.Second - spotifyTokenExpiryBuffer),
+	}
+}
+
+// invalidate drops the cached token for the given credentials, forcing the
+// next get to miss.
+func (c *spotifyTokenCache) invalidate(clientID, clientSecret string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, spotifyCredentialsCacheKey(clientID, clientSecret))
+}
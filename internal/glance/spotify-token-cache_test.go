@@ -0,0 +1,55 @@
+package glance
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSpotifyTokenCacheExpiry(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cache := newSpotifyTokenCache()
+	cache.now = func() time.Time { return now }
+
+	cache.set("client-id", "client-secret", "token-1", 120)
+
+	if token, ok := cache.get("client-id", "client-secret"); !ok || token != "token-1" {
+		t.Fatalf("get() = %q, %v, want %q, true", token, ok, "token-1")
+	}
+
+	// Still within the expiry buffer of 120s - 60s = 60s from now.
+	now = now.Add(59 * time.Second)
+	if _, ok := cache.get("client-id", "client-secret"); !ok {
+		t.Fatal("get() reported a miss before the cached token should have expired")
+	}
+
+	// Past the buffered expiry.
+	now = now.Add(2 * time.Second)
+	if _, ok := cache.get("client-id", "client-secret"); ok {
+		t.Fatal("get() reported a hit for a token past its buffered expiry")
+	}
+}
+
+func TestSpotifyTokenCacheInvalidate(t *testing.T) {
+	cache := newSpotifyTokenCache()
+	cache.set("client-id", "client-secret", "token-1", 120)
+
+	cache.invalidate("client-id", "client-secret")
+
+	if _, ok := cache.get("client-id", "client-secret"); ok {
+		t.Fatal("get() reported a hit for an invalidated token")
+	}
+}
+
+func TestSpotifyTokenCacheKeyedByCredentials(t *testing.T) {
+	cache := newSpotifyTokenCache()
+	cache.set("client-a", "secret", "token-a", 120)
+	cache.set("client-b", "secret", "token-b", 120)
+
+	if token, _ := cache.get("client-a", "secret"); token != "token-a" {
+		t.Errorf("get(client-a) = %q, want %q", token, "token-a")
+	}
+	if token, _ := cache.get("client-b", "secret"); token != "token-b" {
+		t.Errorf("get(client-b) = %q, want %q", token, "token-b")
+	}
+}
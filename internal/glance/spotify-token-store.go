@@ -0,0 +1,132 @@
+package glance
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// spotifyStoredToken is the only piece of Spotify auth state that actually
+// needs to survive a restart - everything else (access token, expiry) is
+// cheap to re-derive from it.
+type spotifyStoredToken struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// spotifyTokenStore persists refresh tokens for widgets using the
+// Authorization Code + PKCE flow, keyed by widget ID, as a single JSON file
+// on disk. One instance is shared by every spotifyProvider in the config so
+// concurrent updates don't clobber each other's writes.
+type spotifyTokenStore struct {
+	path string
+
+	mu     sync.Mutex
+	tokens map[string]spotifyStoredToken
+}
+
+// spotifyDefaultTokenStore is the store spotifyProvider.Init gives every
+// widget using user authorization, rooted in the user's config directory
+// alongside the rest of Glance's state.
+var spotifyDefaultTokenStore = newSpotifyTokenStore(spotifyDefaultTokenStorePath())
+
+func spotifyDefaultTokenStorePath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+
+	return filepath.Join(dir, "glance", "spotify-tokens.json")
+}
+
+func newSpotifyTokenStore(path string) *spotifyTokenStore {
+	return &spotifyTokenStore{
+		path:   path,
+		tokens: make(map[string]spotifyStoredToken),
+	}
+}
+
+func (s *spotifyTokenStore) load(widgetID string) (spotifyStoredToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.readFromDiskLocked(); err != nil {
+		return spotifyStoredToken{}, err
+	}
+
+	token, ok := s.tokens[widgetID]
+	if !ok {
+		return spotifyStoredToken{}, fmt.Errorf("no stored token for widget %q", widgetID)
+	}
+
+	return token, nil
+}
+
+func (s *spotifyTokenStore) save(widgetID string, token spotifyStoredToken) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Re-read first so we don't stomp on tokens saved by another process
+	// (or another widget) since we last loaded the file.
+	if err := s.readFromDiskLocked(); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	s.tokens[widgetID] = token
+
+	return s.writeToDiskLocked()
+}
+
+func (s *spotifyTokenStore) readFromDiskLocked() error {
+	contents, err := os.ReadFile(s.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+
+		return fmt.Errorf("reading Spotify token store: %w", err)
+	}
+
+	var tokens map[string]spotifyStoredToken
+	if err := json.Unmarshal(contents, &tokens); err != nil {
+		return fmt.Errorf("parsing Spotify token store: %w", err)
+	}
+
+	s.tokens = tokens
+
+	return nil
+}
+
+func (s *spotifyTokenStore) writeToDiskLocked() error {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return fmt.Errorf("creating Spotify token store directory: %w", err)
+	}
+
+	contents, err := json.MarshalIndent(s.tokens, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding Spotify token store: %w", err)
+	}
+
+	// Write to a temp file and rename so a crash mid-write can't leave the
+	// store truncated/corrupt.
+	tmpPath := s.path + ".tmp"
+	if err := os.WriteFile(tmpPath, contents, 0o600); err != nil {
+		return fmt.Errorf("writing Spotify token store: %w", err)
+	}
+
+	if err := os.Rename(tmpPath, s.path); err != nil {
+		return fmt.Errorf("finalizing Spotify token store: %w", err)
+	}
+
+	return nil
+}
+
+// spotifyDefaultWidgetID derives a stable identifier for widgets that don't
+// explicitly set `id:` in their config, so each distinct Spotify app/redirect
+// pairing gets its own slot in the token store.
+func spotifyDefaultWidgetID(clientID, redirectURL string) string {
+	sum := sha256.Sum256([]byte(clientID + "|" + redirectURL))
+	return fmt.Sprintf("%x", sum[:8])
+}
@@ -0,0 +1,225 @@
+package glance
+
+import (
+	"context"
+	"fmt"
+	"html/template"
+	"net/http"
+	"strings"
+	"time"
+)
+
+var musicWidgetTemplate = mustParseTemplate("music.html", "widget-base.html")
+
+// Content types shared across providers. Not every provider understands every
+// content type - fetchContent returns an error for ones it doesn't.
+const (
+	musicContentNewReleases      = "new-releases"
+	musicContentTopTracks        = "top-tracks"
+	musicContentTopArtists       = "top-artists"
+	musicContentRecentlyPlayed   = "recently-played"
+	musicContentSavedAlbums      = "saved-albums"
+	musicContentSearch           = "search"
+	musicContentFeaturedPlaylist = "featured-playlists"
+	musicContentCategoryPlaylist = "category-playlists"
+	musicContentArtistTopTracks  = "artist-top-tracks"
+	musicContentAlbumTracks      = "album-tracks"
+	musicPlaylistContentPrefix   = "playlist:"
+)
+
+// musicFetchOptions carries the subset of widget config a provider needs to
+// know about to serve a given content type. Not every field is relevant to
+// every content type - providers ignore what they don't use.
+type musicFetchOptions struct {
+	Limit    int
+	Country  string
+	Query    string
+	Category string
+	ArtistID string
+	AlbumID  string
+}
+
+// musicProvider is implemented by each backend a musicWidget can pull from.
+// The widget itself - and its template - only ever deals in this interface
+// and the []any it returns, so swapping provider: spotify for provider:
+// lastfm in config doesn't require reconfiguring the widget's display
+// options; only the new provider's own credentials (e.g. api-key and
+// username for lastfm) need to be set.
+type musicProvider interface {
+	// Init validates the provider's own config fields and sets up whatever
+	// clients/token sources it needs. widgetID is a stable identifier for
+	// this widget instance; contentType is known up front so Init can decide
+	// whether it needs to set up user authorization for it.
+	Init(widgetID, contentType string) error
+
+	FetchNewReleases(ctx context.Context, opts musicFetchOptions) ([]any, error)
+	FetchTopTracks(ctx context.Context, opts musicFetchOptions) ([]any, error)
+	FetchTopArtists(ctx context.Context, opts musicFetchOptions) ([]any, error)
+	FetchRecentlyPlayed(ctx context.Context, opts musicFetchOptions) ([]any, error)
+	FetchSavedAlbums(ctx context.Context, opts musicFetchOptions) ([]any, error)
+	SearchAlbum(ctx context.Context, opts musicFetchOptions) ([]any, error)
+}
+
+// musicProviderExtra is implemented by providers that support content types
+// beyond the common set above - currently just Spotify's catalog browsing
+// endpoints, which don't have an equivalent on every provider.
+type musicProviderExtra interface {
+	FetchFeaturedPlaylists(ctx context.Context, opts musicFetchOptions) ([]any, error)
+	FetchCategoryPlaylists(ctx context.Context, opts musicFetchOptions) ([]any, error)
+	FetchArtistTopTracks(ctx context.Context, opts musicFetchOptions) ([]any, error)
+	FetchAlbumTracks(ctx context.Context, opts musicFetchOptions) ([]any, error)
+	FetchPlaylistTracks(ctx context.Context, opts musicFetchOptions, playlistID string) ([]any, error)
+}
+
+// musicProviderRequestHandler is implemented by providers that need routes
+// outside the normal render cycle, e.g. Spotify's OAuth redirect callback.
+type musicProviderRequestHandler interface {
+	HandleRequest(rw http.ResponseWriter, req *http.Request)
+}
+
+// Widget struct - main configuration and data
+type musicWidget struct {
+	widgetBase   `yaml:",inline"`
+	ID           string `yaml:"id"`
+	Provider     string `yaml:"provider"`
+	ClientID     string `yaml:"client-id"`
+	ClientSecret string `yaml:"client-secret"`
+	RedirectURL  string `yaml:"redirect-url"`
+	APIKey       string `yaml:"api-key"`
+	Username     string `yaml:"username"`
+	Country      string `yaml:"country"`
+	Limit        int    `yaml:"limit"`
+	ContentType  string `yaml:"content-type"`
+	Query        string `yaml:"query"`
+	Category     string `yaml:"category"`
+	ArtistID     string `yaml:"artist-id"`
+	AlbumID      string `yaml:"album-id"`
+
+	// Items holds whatever the configured provider/content-type returned.
+	// The concrete element type depends on ContentType: MusicAlbum,
+	// MusicTrack, MusicArtist or MusicPlaylist.
+	Items []any `yaml:"-"`
+
+	provider musicProvider `yaml:"-"`
+}
+
+// Required widget interface methods
+func (w *musicWidget) initialize() error {
+	if w.Provider == "" {
+		w.Provider = "spotify"
+	}
+	if w.Country == "" {
+		w.Country = "US" // Default to US market
+	}
+	if w.Limit <= 0 {
+		w.Limit = 10 // Default to 10 items
+	}
+	if w.ContentType == "" {
+		w.ContentType = musicContentNewReleases // Default content type
+	}
+
+	switch w.Provider {
+	case "spotify":
+		w.withTitle("Spotify")
+		w.provider = &spotifyProvider{
+			clientID:     w.ClientID,
+			clientSecret: w.ClientSecret,
+			redirectURL:  w.RedirectURL,
+		}
+	case "lastfm":
+		w.withTitle("Last.fm")
+		w.provider = &lastfmProvider{
+			apiKey:   w.APIKey,
+			username: w.Username,
+		}
+	default:
+		return fmt.Errorf("unsupported provider %q", w.Provider)
+	}
+
+	w.withCacheDuration(time.Hour)
+
+	return w.provider.Init(w.ID, w.ContentType)
+}
+
+func (w *musicWidget) update(ctx context.Context) {
+	opts := musicFetchOptions{
+		Limit:    w.Limit,
+		Country:  w.Country,
+		Query:    w.Query,
+		Category: w.Category,
+		ArtistID: w.ArtistID,
+		AlbumID:  w.AlbumID,
+	}
+
+	items, err := w.fetchContent(ctx, opts)
+	if err != nil {
+		w.canContinueUpdateAfterHandlingErr(err)
+		return
+	}
+
+	w.Items = items
+
+	w.canContinueUpdateAfterHandlingErr(nil)
+}
+
+// fetchContent dispatches to the provider method matching w.ContentType.
+func (w *musicWidget) fetchContent(ctx context.Context, opts musicFetchOptions) ([]any, error) {
+	switch w.ContentType {
+	case musicContentNewReleases:
+		return w.provider.FetchNewReleases(ctx, opts)
+	case musicContentTopTracks:
+		return w.provider.FetchTopTracks(ctx, opts)
+	case musicContentTopArtists:
+		return w.provider.FetchTopArtists(ctx, opts)
+	case musicContentRecentlyPlayed:
+		return w.provider.FetchRecentlyPlayed(ctx, opts)
+	case musicContentSavedAlbums:
+		return w.provider.FetchSavedAlbums(ctx, opts)
+	case musicContentSearch:
+		return w.provider.SearchAlbum(ctx, opts)
+	}
+
+	extra, ok := w.provider.(musicProviderExtra)
+	if !ok {
+		return nil, fmt.Errorf("provider %q does not support content-type %q", w.Provider, w.ContentType)
+	}
+
+	switch {
+	case w.ContentType == musicContentFeaturedPlaylist:
+		return extra.FetchFeaturedPlaylists(ctx, opts)
+	case w.ContentType == musicContentCategoryPlaylist:
+		return extra.FetchCategoryPlaylists(ctx, opts)
+	case w.ContentType == musicContentArtistTopTracks:
+		return extra.FetchArtistTopTracks(ctx, opts)
+	case w.ContentType == musicContentAlbumTracks:
+		return extra.FetchAlbumTracks(ctx, opts)
+	case strings.HasPrefix(w.ContentType, musicPlaylistContentPrefix):
+		return extra.FetchPlaylistTracks(ctx, opts, strings.TrimPrefix(w.ContentType, musicPlaylistContentPrefix))
+	default:
+		return nil, fmt.Errorf("unsupported content-type %q", w.ContentType)
+	}
+}
+
+func (w *musicWidget) Render() template.HTML {
+	return w.renderTemplate(w, musicWidgetTemplate)
+}
+
+// HandleRequest serves routes a provider needs outside of the normal render
+// cycle, e.g. Spotify's "connect account" link and OAuth callback.
+func (w *musicWidget) HandleRequest(rw http.ResponseWriter, req *http.Request) {
+	handler, ok := w.provider.(musicProviderRequestHandler)
+	if !ok {
+		http.NotFound(rw, req)
+		return
+	}
+
+	handler.HandleRequest(rw, req)
+}
+
+// Helper function for absolute value (Go doesn't have built-in abs for int)
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}